@@ -71,6 +71,10 @@ func resourceAutomationRunbook() *pluginsdk.Resource {
 					string(automation.RunbookTypeEnumPowerShell),
 					string(automation.RunbookTypeEnumPowerShellWorkflow),
 					string(automation.RunbookTypeEnumScript),
+					// these aren't defined in the SDK enum but are accepted by the API
+					"PowerShell72",
+					"Python2",
+					"Python3",
 				}, false),
 			},
 
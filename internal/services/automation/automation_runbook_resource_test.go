@@ -78,6 +78,38 @@ func TestAccAutomationRunbook_PSWithContent(t *testing.T) {
 	})
 }
 
+func TestAccAutomationRunbook_PS72WithContent(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_runbook", "test")
+	r := AutomationRunbookResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.runbookTypeWithContent(data, "PowerShell72"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("runbook_type").HasValue("PowerShell72"),
+			),
+		},
+		data.ImportStep("publish_content_link"),
+	})
+}
+
+func TestAccAutomationRunbook_Python3WithContent(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_runbook", "test")
+	r := AutomationRunbookResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.runbookTypeWithContent(data, "Python3"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("runbook_type").HasValue("Python3"),
+			),
+		},
+		data.ImportStep("publish_content_link"),
+	})
+}
+
 func TestAccAutomationRunbook_PSWorkflowWithoutUri(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_automation_runbook", "test")
 	r := AutomationRunbookResource{}
@@ -291,6 +323,43 @@ CONTENT
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (AutomationRunbookResource) runbookTypeWithContent(data acceptance.TestData, runbookType string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-auto-%d"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Basic"
+}
+
+resource "azurerm_automation_runbook" "test" {
+  name                    = "Get-AzureVMTutorial"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+
+  log_verbose  = "true"
+  log_progress = "true"
+  description  = "This is a test runbook for terraform acceptance test"
+  runbook_type = "%s"
+
+  content = <<CONTENT
+# Some test content
+# for Terraform acceptance test
+CONTENT
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, runbookType)
+}
+
 func (AutomationRunbookResource) PSWorkflowWithoutUri(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
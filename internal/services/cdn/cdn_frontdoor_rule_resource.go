@@ -1,6 +1,7 @@
 package cdn
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -36,6 +37,8 @@ func resourceCdnFrontDoorRule() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(cdnFrontDoorRuleCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 
 			"name": {
@@ -800,6 +803,30 @@ func resourceCdnFrontDoorRuleDelete(d *pluginsdk.ResourceData, meta interface{})
 	return nil
 }
 
+func cdnFrontDoorRuleCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	// the actions and conditions are validated when they are expanded, running the expanders here
+	// surfaces invalid combinations during the plan rather than after the Rules Engine rejects them.
+	// This is only done once the block is wholly known since unknown values are read as empty strings
+	rawConfig := diff.GetRawConfig()
+	if !rawConfig.IsKnown() || rawConfig.IsNull() {
+		return nil
+	}
+
+	if rawConfig.GetAttr("actions").IsWhollyKnown() {
+		if _, err := expandFrontdoorDeliveryRuleActions(diff.Get("actions").([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if rawConfig.GetAttr("conditions").IsWhollyKnown() {
+		if _, err := expandFrontdoorDeliveryRuleConditions(diff.Get("conditions").([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func expandFrontdoorDeliveryRuleActions(input []interface{}) ([]cdn.BasicDeliveryRuleAction, error) {
 	results := make([]cdn.BasicDeliveryRuleAction, 0)
 	if len(input) == 0 {
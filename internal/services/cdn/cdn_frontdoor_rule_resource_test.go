@@ -104,6 +104,18 @@ func TestAccCdnFrontDoorRule_invalidCacheDuration(t *testing.T) {
 	})
 }
 
+func TestAccCdnFrontDoorRule_invalidActionsCombination(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_rule", "test")
+	r := CdnFrontDoorRuleResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidActionsCombination(data),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile(`are both present in the "actions" match block`),
+		},
+	})
+}
+
 func (r CdnFrontDoorRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.FrontDoorRuleID(state.ID)
 	if err != nil {
@@ -431,3 +443,36 @@ resource "azurerm_cdn_frontdoor_rule" "test" {
 }
 `, template, data.RandomInteger)
 }
+
+func (r CdnFrontDoorRuleResource) invalidActionsCombination(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_cdn_frontdoor_rule" "test" {
+  depends_on = [azurerm_cdn_frontdoor_origin_group.test, azurerm_cdn_frontdoor_origin.test]
+
+  name                      = "accTestRule%d"
+  cdn_frontdoor_rule_set_id = azurerm_cdn_frontdoor_rule_set.test.id
+  order                     = 1
+
+  actions {
+    url_redirect_action {
+      redirect_type        = "PermanentRedirect"
+      redirect_protocol    = "MatchRequest"
+      destination_hostname = "contoso.com"
+    }
+
+    url_rewrite_action {
+      source_pattern          = "/"
+      destination             = "/index.html"
+      preserve_unmatched_path = false
+    }
+  }
+}
+`, template, data.RandomInteger)
+}
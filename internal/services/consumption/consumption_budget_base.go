@@ -199,6 +199,12 @@ func (br consumptionBudgetBaseResource) arguments(fields map[string]*pluginsdk.S
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 					},
+
+					"locale": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(budgets.PossibleValuesForCultureCode(), false),
+					},
 				},
 			},
 		},
@@ -572,6 +578,11 @@ func expandConsumptionBudgetNotifications(input []interface{}) *map[string]budge
 				notification.ContactGroups = utils.ExpandStringSlice(notificationRaw["contact_groups"].([]interface{}))
 			}
 
+			if v := notificationRaw["locale"].(string); v != "" {
+				locale := budgets.CultureCode(v)
+				notification.Locale = &locale
+			}
+
 			notificationKey := fmt.Sprintf("%s_%s_%f_Percent", string(thresholdType), string(notification.Operator), notification.Threshold)
 			notifications[notificationKey] = notification
 		}
@@ -605,6 +616,12 @@ func flattenConsumptionBudgetNotifications(input *map[string]budgets.Notificatio
 		}
 		block["threshold_type"] = thresholdType
 
+		locale := ""
+		if v := n.Locale; v != nil {
+			locale = string(*v)
+		}
+		block["locale"] = locale
+
 		var emails []interface{}
 		if v := n.ContactEmails; v != nil {
 			emails = utils.FlattenStringSlice(&v)
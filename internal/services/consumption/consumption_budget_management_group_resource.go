@@ -83,6 +83,12 @@ func (r ManagementGroupConsumptionBudget) Arguments() map[string]*pluginsdk.Sche
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 					},
+
+					"locale": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(budgets.PossibleValuesForCultureCode(), false),
+					},
 				},
 			},
 		},
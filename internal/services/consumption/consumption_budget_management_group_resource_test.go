@@ -305,6 +305,7 @@ resource "azurerm_consumption_budget_management_group" "test" {
     enabled   = true
     threshold = 90.0
     operator  = "EqualTo"
+    locale    = "en-gb"
 
     contact_emails = [
       "foo@example.com",
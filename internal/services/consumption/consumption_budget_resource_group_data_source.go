@@ -189,6 +189,11 @@ func resourceArmConsumptionBudgetResourceGroupDataSource() *pluginsdk.Resource {
 								Type: pluginsdk.TypeString,
 							},
 						},
+
+						"locale": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -315,6 +315,7 @@ resource "azurerm_consumption_budget_resource_group" "test" {
     enabled        = true
     threshold      = 90.0
     operator       = "EqualTo"
+    locale         = "en-gb"
     threshold_type = "Forecasted"
 
     contact_emails = [
@@ -189,6 +189,11 @@ func resourceArmConsumptionBudgetSubscriptionDataSource() *pluginsdk.Resource {
 								Type: pluginsdk.TypeString,
 							},
 						},
+
+						"locale": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -300,6 +300,7 @@ resource "azurerm_consumption_budget_subscription" "test" {
     enabled   = true
     threshold = 90.0
     operator  = "EqualTo"
+    locale    = "en-gb"
 
     contact_emails = [
       "foo@example.com",
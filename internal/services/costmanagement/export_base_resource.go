@@ -49,6 +49,12 @@ func (br costManagementExportBaseResource) arguments(fields map[string]*pluginsd
 			ValidateFunc: validation.IsRFC3339Time,
 		},
 
+		"partition_data_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
 		"export_data_storage_location": {
 			Type:     pluginsdk.TypeList,
 			MaxItems: 1,
@@ -180,6 +186,12 @@ func (br costManagementExportBaseResource) readFunc(scopeFieldName string) sdk.R
 						metadata.ResourceData.Set("recurrence_type", schedule.Recurrence)
 					}
 
+					partitionDataEnabled := false
+					if v := props.PartitionData; v != nil {
+						partitionDataEnabled = *v
+					}
+					metadata.ResourceData.Set("partition_data_enabled", partitionDataEnabled)
+
 					exportDeliveryInfo, err := flattenExportDataStorageLocation(&props.DeliveryInfo)
 					if err != nil {
 						return fmt.Errorf("flattening `export_data_storage_location`: %+v", err)
@@ -275,9 +287,10 @@ func createOrUpdateCostManagementExport(ctx context.Context, client *exports.Exp
 				},
 				Status: &status,
 			},
-			DeliveryInfo: *deliveryInfo,
-			Format:       &format,
-			Definition:   *expandExportDefinition(metadata.ResourceData.Get("export_data_options").([]interface{})),
+			DeliveryInfo:  *deliveryInfo,
+			Format:        &format,
+			PartitionData: utils.Bool(metadata.ResourceData.Get("partition_data_enabled").(bool)),
+			Definition:    *expandExportDefinition(metadata.ResourceData.Get("export_data_options").([]interface{})),
 		},
 	}
 
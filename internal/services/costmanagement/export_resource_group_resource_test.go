@@ -168,6 +168,7 @@ resource "azurerm_resource_group_cost_management_export" "test" {
   recurrence_type              = "Monthly"
   recurrence_period_start_date = "%sT00:00:00Z"
   recurrence_period_end_date   = "%sT00:00:00Z"
+  partition_data_enabled       = true
 
   export_data_storage_location {
     container_id     = azurerm_storage_container.test.resource_manager_id
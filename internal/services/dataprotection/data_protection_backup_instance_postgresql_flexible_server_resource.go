@@ -0,0 +1,206 @@
+package dataprotection
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2022-04-01/backupinstances"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2022-04-01/backuppolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2021-06-01/servers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	azSchema "github.com/hashicorp/terraform-provider-azurerm/internal/tf/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceDataProtectionBackupInstancePostgreSQLFlexibleServer() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataProtectionBackupInstancePostgreSQLFlexibleServerCreateUpdate,
+		Read:   resourceDataProtectionBackupInstancePostgreSQLFlexibleServerRead,
+		Update: resourceDataProtectionBackupInstancePostgreSQLFlexibleServerCreateUpdate,
+		Delete: resourceDataProtectionBackupInstancePostgreSQLFlexibleServerDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := backupinstances.ParseBackupInstanceID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": commonschema.Location(),
+
+			"vault_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: backupinstances.ValidateBackupVaultID,
+			},
+
+			"server_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: servers.ValidateFlexibleServerID,
+			},
+
+			"backup_policy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: backuppolicies.ValidateBackupPolicyID,
+			},
+		},
+	}
+}
+
+func resourceDataProtectionBackupInstancePostgreSQLFlexibleServerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	client := meta.(*clients.Client).DataProtection.BackupInstanceClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	vaultId, _ := backupinstances.ParseBackupVaultID(d.Get("vault_id").(string))
+
+	id := backupinstances.NewBackupInstanceID(subscriptionId, vaultId.ResourceGroupName, vaultId.VaultName, name)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing DataProtection BackupInstance (%q): %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_data_protection_backup_instance_postgresql_flexible_server", id.ID())
+		}
+	}
+
+	serverId, _ := servers.ParseFlexibleServerID(d.Get("server_id").(string))
+	location := location.Normalize(d.Get("location").(string))
+	policyId, _ := backuppolicies.ParseBackupPolicyID(d.Get("backup_policy_id").(string))
+
+	// unlike Single Server databases, no credentials are required since the vault uses its managed identity,
+	// which must be granted access to the server (and read access to its resource group) before protection can be configured
+	parameters := backupinstances.BackupInstanceResource{
+		Properties: &backupinstances.BackupInstance{
+			DataSourceInfo: backupinstances.Datasource{
+				DatasourceType:   utils.String("Microsoft.DBforPostgreSQL/flexibleServers"),
+				ObjectType:       utils.String("Datasource"),
+				ResourceID:       serverId.ID(),
+				ResourceLocation: utils.String(location),
+				ResourceName:     utils.String(serverId.ServerName),
+				ResourceType:     utils.String("Microsoft.DBforPostgreSQL/flexibleServers"),
+				ResourceUri:      utils.String(serverId.ID()),
+			},
+			DataSourceSetInfo: &backupinstances.DatasourceSet{
+				DatasourceType:   utils.String("Microsoft.DBforPostgreSQL/flexibleServers"),
+				ObjectType:       utils.String("DatasourceSet"),
+				ResourceID:       serverId.ID(),
+				ResourceLocation: utils.String(location),
+				ResourceName:     utils.String(serverId.ServerName),
+				ResourceType:     utils.String("Microsoft.DBforPostgreSQL/flexibleServers"),
+				ResourceUri:      utils.String(serverId.ID()),
+			},
+			FriendlyName: utils.String(id.BackupInstanceName),
+			PolicyInfo: backupinstances.PolicyInfo{
+				PolicyId: policyId.ID(),
+			},
+		},
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{string(backupinstances.StatusConfiguringProtection), "UpdatingProtection"},
+		Target:     []string{string(backupinstances.StatusProtectionConfigured)},
+		Refresh:    policyProtectionStateRefreshFunc(ctx, client, id),
+		MinTimeout: 1 * time.Minute,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for BackupInstance(%q) policy protection to be completed: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceDataProtectionBackupInstancePostgreSQLFlexibleServerRead(d, meta)
+}
+
+func resourceDataProtectionBackupInstancePostgreSQLFlexibleServerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataProtection.BackupInstanceClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := backupinstances.ParseBackupInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] dataprotection %q does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving DataProtection BackupInstance (%q): %+v", id, err)
+	}
+	vaultId := backupinstances.NewBackupVaultID(id.SubscriptionId, id.ResourceGroupName, id.VaultName)
+	d.Set("name", id.BackupInstanceName)
+	d.Set("vault_id", vaultId.ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			serverId, err := servers.ParseFlexibleServerIDInsensitively(props.DataSourceInfo.ResourceID)
+			if err != nil {
+				return err
+			}
+			d.Set("server_id", serverId.ID())
+			d.Set("location", location.NormalizeNilable(props.DataSourceInfo.ResourceLocation))
+			d.Set("backup_policy_id", props.PolicyInfo.PolicyId)
+		}
+	}
+	return nil
+}
+
+func resourceDataProtectionBackupInstancePostgreSQLFlexibleServerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataProtection.BackupInstanceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := backupinstances.ParseBackupInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting DataProtection BackupInstance (%q): %+v", id, err)
+	}
+
+	return nil
+}
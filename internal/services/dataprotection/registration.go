@@ -35,13 +35,15 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_data_protection_backup_instance_blob_storage": resourceDataProtectionBackupInstanceBlobStorage(),
-		"azurerm_data_protection_backup_instance_disk":         resourceDataProtectionBackupInstanceDisk(),
-		"azurerm_data_protection_backup_instance_postgresql":   resourceDataProtectionBackupInstancePostgreSQL(),
-		"azurerm_data_protection_backup_policy_blob_storage":   resourceDataProtectionBackupPolicyBlobStorage(),
-		"azurerm_data_protection_backup_policy_disk":           resourceDataProtectionBackupPolicyDisk(),
-		"azurerm_data_protection_backup_policy_postgresql":     resourceDataProtectionBackupPolicyPostgreSQL(),
-		"azurerm_data_protection_backup_vault":                 resourceDataProtectionBackupVault(),
-		"azurerm_data_protection_resource_guard":               resourceDataProtectionResourceGuard(),
+		"azurerm_data_protection_backup_instance_blob_storage":               resourceDataProtectionBackupInstanceBlobStorage(),
+		"azurerm_data_protection_backup_instance_disk":                       resourceDataProtectionBackupInstanceDisk(),
+		"azurerm_data_protection_backup_instance_postgresql":                 resourceDataProtectionBackupInstancePostgreSQL(),
+		"azurerm_data_protection_backup_instance_postgresql_flexible_server": resourceDataProtectionBackupInstancePostgreSQLFlexibleServer(),
+		"azurerm_data_protection_backup_policy_blob_storage":                 resourceDataProtectionBackupPolicyBlobStorage(),
+		"azurerm_data_protection_backup_policy_disk":                         resourceDataProtectionBackupPolicyDisk(),
+		"azurerm_data_protection_backup_policy_postgresql":                   resourceDataProtectionBackupPolicyPostgreSQL(),
+		"azurerm_data_protection_backup_policy_postgresql_flexible_server":   resourceDataProtectionBackupPolicyPostgreSQLFlexibleServer(),
+		"azurerm_data_protection_backup_vault":                               resourceDataProtectionBackupVault(),
+		"azurerm_data_protection_resource_guard":                             resourceDataProtectionResourceGuard(),
 	}
 }
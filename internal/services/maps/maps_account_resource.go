@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func resourceMapsAccount() *pluginsdk.Resource {
@@ -57,6 +58,12 @@ func resourceMapsAccount() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"local_authentication_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"tags": commonschema.Tags(),
 
 			"x_ms_client_id": {
@@ -106,6 +113,9 @@ func resourceMapsAccountCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 		Sku: accounts.Sku{
 			Name: accounts.Name(d.Get("sku_name").(string)),
 		},
+		Properties: &accounts.MapsAccountProperties{
+			DisableLocalAuth: utils.Bool(!d.Get("local_authentication_enabled").(bool)),
+		},
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
@@ -147,6 +157,12 @@ func resourceMapsAccountRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("sku_name", string(model.Sku.Name))
 		if props := model.Properties; props != nil {
 			d.Set("x_ms_client_id", props.UniqueId)
+
+			localAuthenticationEnabled := true
+			if props.DisableLocalAuth != nil {
+				localAuthenticationEnabled = !*props.DisableLocalAuth
+			}
+			d.Set("local_authentication_enabled", localAuthenticationEnabled)
 		}
 
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
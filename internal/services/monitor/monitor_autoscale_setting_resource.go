@@ -494,6 +494,8 @@ func resourceMonitorAutoScaleSettingRead(d *pluginsdk.ResourceData, meta interfa
 	if err != nil {
 		return fmt.Errorf("flattening `profile` of %s: %+v", *id, err)
 	}
+	// the API doesn't guarantee the order of the profiles, so align them with the existing order to avoid a perpetual diff
+	profile = sortAzureRmMonitorAutoScaleSettingProfiles(profile, d.Get("profile").([]interface{}))
 	if err = d.Set("profile", profile); err != nil {
 		return fmt.Errorf("setting `profile` of %s: %+v", *id, err)
 	}
@@ -802,6 +804,42 @@ func flattenAzureRmMonitorAutoScaleSettingProfile(profiles *[]insights.Autoscale
 	return results, nil
 }
 
+func sortAzureRmMonitorAutoScaleSettingProfiles(profiles []interface{}, existing []interface{}) []interface{} {
+	if len(existing) == 0 {
+		return profiles
+	}
+
+	results := make([]interface{}, 0, len(profiles))
+	used := make([]bool, len(profiles))
+	for _, v := range existing {
+		raw, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for i, profile := range profiles {
+			if used[i] {
+				continue
+			}
+
+			if profile.(map[string]interface{})["name"] == raw["name"] {
+				results = append(results, profile)
+				used[i] = true
+				break
+			}
+		}
+	}
+
+	// any profiles which don't exist in the state (e.g. those added outside of Terraform) are appended in the order returned by the API
+	for i, profile := range profiles {
+		if !used[i] {
+			results = append(results, profile)
+		}
+	}
+
+	return results
+}
+
 func flattenAzureRmMonitorAutoScaleSettingCapacity(input *insights.ScaleCapacity) ([]interface{}, error) {
 	if input == nil {
 		return []interface{}{}, nil
@@ -231,6 +231,25 @@ func TestAccMonitorAutoScaleSetting_recurrenceUpdate(t *testing.T) {
 	})
 }
 
+func TestAccMonitorAutoScaleSetting_multipleRecurrenceProfiles(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_autoscale_setting", "test")
+	r := MonitorAutoScaleSettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multipleRecurrenceProfiles(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("profile.#").HasValue("3"),
+				check.That(data.ResourceName).Key("profile.0.name").HasValue("weekdays"),
+				check.That(data.ResourceName).Key("profile.1.name").HasValue("weekends"),
+				check.That(data.ResourceName).Key("profile.2.name").HasValue("fixedDate"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccMonitorAutoScaleSetting_fixedDate(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_monitor_autoscale_setting", "test")
 	r := MonitorAutoScaleSettingResource{}
@@ -781,6 +800,83 @@ resource "azurerm_monitor_autoscale_setting" "test" {
 `, template, data.RandomInteger)
 }
 
+func (MonitorAutoScaleSettingResource) multipleRecurrenceProfiles(data acceptance.TestData) string {
+	template := MonitorAutoScaleSettingResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_autoscale_setting" "test" {
+  name                = "acctestautoscale-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  target_resource_id  = azurerm_linux_virtual_machine_scale_set.test.id
+
+  profile {
+    name = "weekdays"
+
+    capacity {
+      default = 2
+      minimum = 1
+      maximum = 10
+    }
+
+    recurrence {
+      timezone = "Pacific Standard Time"
+
+      days = [
+        "Monday",
+        "Tuesday",
+        "Wednesday",
+        "Thursday",
+        "Friday",
+      ]
+
+      hours   = [8]
+      minutes = [0]
+    }
+  }
+
+  profile {
+    name = "weekends"
+
+    capacity {
+      default = 1
+      minimum = 1
+      maximum = 5
+    }
+
+    recurrence {
+      timezone = "Pacific Standard Time"
+
+      days = [
+        "Saturday",
+        "Sunday",
+      ]
+
+      hours   = [0]
+      minutes = [0]
+    }
+  }
+
+  profile {
+    name = "fixedDate"
+
+    capacity {
+      default = 1
+      minimum = 1
+      maximum = 30
+    }
+
+    fixed_date {
+      timezone = "Pacific Standard Time"
+      start    = "2020-06-18T00:00:00Z"
+      end      = "2020-06-18T23:59:59Z"
+    }
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (MonitorAutoScaleSettingResource) fixedDate(data acceptance.TestData) string {
 	template := MonitorAutoScaleSettingResource{}.template(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,55 @@
+package monitor
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSortAzureRmMonitorAutoScaleSettingProfiles(t *testing.T) {
+	profile := func(name string) interface{} {
+		return map[string]interface{}{
+			"name": name,
+		}
+	}
+
+	testData := []struct {
+		name     string
+		profiles []interface{}
+		existing []interface{}
+		expected []interface{}
+	}{
+		{
+			name:     "import with no existing profiles",
+			profiles: []interface{}{profile("b"), profile("a")},
+			existing: []interface{}{},
+			expected: []interface{}{profile("b"), profile("a")},
+		},
+		{
+			name:     "reordered API response",
+			profiles: []interface{}{profile("c"), profile("a"), profile("b")},
+			existing: []interface{}{profile("a"), profile("b"), profile("c")},
+			expected: []interface{}{profile("a"), profile("b"), profile("c")},
+		},
+		{
+			name:     "profiles missing from state are appended in API order",
+			profiles: []interface{}{profile("d"), profile("b"), profile("c"), profile("a")},
+			existing: []interface{}{profile("a"), profile("b")},
+			expected: []interface{}{profile("a"), profile("b"), profile("d"), profile("c")},
+		},
+		{
+			name:     "profile removed outside of Terraform",
+			profiles: []interface{}{profile("c"), profile("a")},
+			existing: []interface{}{profile("a"), profile("b"), profile("c")},
+			expected: []interface{}{profile("a"), profile("c")},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual := sortAzureRmMonitorAutoScaleSettingProfiles(v.profiles, v.existing)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("Expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
@@ -85,6 +85,13 @@ func resourceNetAppPool() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"cool_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
 			"tags": commonschema.Tags(),
 		},
 	}
@@ -118,6 +125,7 @@ func resourceNetAppPoolCreate(d *pluginsdk.ResourceData, meta interface{}) error
 		Properties: capacitypools.PoolProperties{
 			ServiceLevel: capacitypools.ServiceLevel(d.Get("service_level").(string)),
 			Size:         sizeInBytes,
+			CoolAccess:   utils.Bool(d.Get("cool_access_enabled").(bool)),
 		},
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
@@ -232,6 +240,12 @@ func resourceNetAppPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 		d.Set("qos_type", qosType)
 
+		coolAccessEnabled := false
+		if poolProperties.CoolAccess != nil {
+			coolAccessEnabled = *poolProperties.CoolAccess
+		}
+		d.Set("cool_access_enabled", coolAccessEnabled)
+
 		return tags.FlattenAndSet(d, model.Tags)
 	}
 
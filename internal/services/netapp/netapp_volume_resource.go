@@ -44,6 +44,16 @@ func resourceNetAppVolume() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			// `cool_access_period_in_days` is optional and computed, so the value can remain in the state after it's been removed from the
+			// configuration - as such we have to check the raw config to determine whether it's been specified
+			if rawConfig := diff.GetRawConfig(); !rawConfig.IsNull() && !diff.Get("cool_access_enabled").(bool) && !rawConfig.GetAttr("cool_access_period_in_days").IsNull() {
+				return fmt.Errorf("`cool_access_period_in_days` can only be specified when `cool_access_enabled` is set to `true`")
+			}
+
+			return nil
+		}),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -153,6 +163,21 @@ func resourceNetAppVolume() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"cool_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"cool_access_period_in_days": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(7, 63),
+			},
+
 			"export_policy_rule": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -436,6 +461,14 @@ func resourceNetAppVolumeCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		parameters.Properties.ThroughputMibps = utils.Float(throughputMibps.(float64))
 	}
 
+	if d.Get("cool_access_enabled").(bool) {
+		parameters.Properties.CoolAccess = utils.Bool(true)
+
+		if coolnessPeriod, ok := d.GetOk("cool_access_period_in_days"); ok {
+			parameters.Properties.CoolnessPeriod = utils.Int64(int64(coolnessPeriod.(int)))
+		}
+	}
+
 	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
@@ -579,6 +612,18 @@ func resourceNetAppVolumeRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("snapshot_directory_visible", props.SnapshotDirectoryVisible)
 		d.Set("throughput_in_mibps", props.ThroughputMibps)
 		d.Set("storage_quota_in_gb", props.UsageThreshold/1073741824)
+
+		coolAccessEnabled := false
+		if props.CoolAccess != nil {
+			coolAccessEnabled = *props.CoolAccess
+		}
+		d.Set("cool_access_enabled", coolAccessEnabled)
+
+		coolnessPeriod := 0
+		if props.CoolnessPeriod != nil {
+			coolnessPeriod = int(*props.CoolnessPeriod)
+		}
+		d.Set("cool_access_period_in_days", coolnessPeriod)
 		if err := d.Set("export_policy_rule", flattenNetAppVolumeExportPolicyRule(props.ExportPolicy)); err != nil {
 			return fmt.Errorf("setting `export_policy_rule`: %+v", err)
 		}
@@ -0,0 +1,68 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2021-12-01/backup"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// NOTE: this workaround client exists since the SDK's `Put` method doesn't send the request body,
+// meaning the Resource Guard can't be specified
+type ResourceGuardProxyWorkaroundClient struct {
+	sdkClient *backup.ResourceGuardProxyClient
+}
+
+func NewResourceGuardProxyWorkaroundClient(client *backup.ResourceGuardProxyClient) ResourceGuardProxyWorkaroundClient {
+	return ResourceGuardProxyWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+func (c ResourceGuardProxyWorkaroundClient) Put(ctx context.Context, vaultName string, resourceGroupName string, resourceGuardProxyName string, parameters backup.ResourceGuardProxyBaseResource) (result backup.ResourceGuardProxyBaseResource, err error) {
+	req, err := c.PutPreparer(ctx, vaultName, resourceGroupName, resourceGuardProxyName, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "backup.ResourceGuardProxyClient", "Put", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.sdkClient.PutSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "backup.ResourceGuardProxyClient", "Put", resp, "Failure sending request")
+		return
+	}
+
+	result, err = c.sdkClient.PutResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "backup.ResourceGuardProxyClient", "Put", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// PutPreparer prepares the Put request.
+func (c ResourceGuardProxyWorkaroundClient) PutPreparer(ctx context.Context, vaultName string, resourceGroupName string, resourceGuardProxyName string, parameters backup.ResourceGuardProxyBaseResource) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"resourceGroupName":      autorest.Encode("path", resourceGroupName),
+		"resourceGuardProxyName": autorest.Encode("path", resourceGuardProxyName),
+		"subscriptionId":         autorest.Encode("path", c.sdkClient.SubscriptionID),
+		"vaultName":              autorest.Encode("path", vaultName),
+	}
+
+	const APIVersion = "2021-12-01"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.sdkClient.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.RecoveryServices/vaults/{vaultName}/backupResourceGuardProxies/{resourceGuardProxyName}", pathParameters),
+		autorest.WithJSON(parameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
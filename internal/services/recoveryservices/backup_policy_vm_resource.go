@@ -50,6 +50,14 @@ func resourceBackupProtectionPolicyVM() *pluginsdk.Resource {
 			_, hasDaily := diff.GetOk("retention_daily")
 			_, hasWeekly := diff.GetOk("retention_weekly")
 
+			if mode, _ := diff.GetOk("tiering_policy.0.archived_restore_point.0.mode"); mode.(string) == string(backup.TieringModeTierAfter) {
+				_, hasDuration := diff.GetOk("tiering_policy.0.archived_restore_point.0.duration")
+				_, hasDurationType := diff.GetOk("tiering_policy.0.archived_restore_point.0.duration_type")
+				if !hasDuration || !hasDurationType {
+					return fmt.Errorf("`tiering_policy.0.archived_restore_point.0.duration` and `tiering_policy.0.archived_restore_point.0.duration_type` must be set when `mode` is `TierAfter`")
+				}
+			}
+
 			frequency, _ := diff.GetOk("backup.0.frequency")
 			switch frequency.(string) {
 			case string(backup.ScheduleRunTypeHourly):
@@ -154,6 +162,17 @@ func resourceBackupProtectionPolicyVMCreateUpdate(d *pluginsdk.ResourceData, met
 			MonthlySchedule:     expandBackupProtectionPolicyVMRetentionMonthly(d, times),
 			YearlySchedule:      expandBackupProtectionPolicyVMRetentionYearly(d, times),
 		},
+		TieringPolicy: expandBackupProtectionPolicyVMTieringPolicy(d.Get("tiering_policy").([]interface{})),
+	}
+
+	// removing the `tiering_policy` block should turn tiering off, so rather than relying on the service
+	// to clear an existing policy when none is sent we explicitly set the archive tier to `DoNotTier`
+	if vmProtectionPolicyProperties.TieringPolicy == nil && !d.IsNewResource() && d.HasChange("tiering_policy") {
+		vmProtectionPolicyProperties.TieringPolicy = map[string]*backup.TieringPolicy{
+			string(backup.RecoveryPointTierTypeArchivedRP): {
+				TieringMode: backup.TieringModeDoNotTier,
+			},
+		}
 	}
 
 	if d.HasChange("instant_restore_retention_days") {
@@ -236,6 +255,10 @@ func resourceBackupProtectionPolicyVMRead(d *pluginsdk.ResourceData, meta interf
 		}
 		d.Set("policy_type", policyType)
 
+		if err := d.Set("tiering_policy", flattenBackupProtectionPolicyVMTieringPolicy(properties.TieringPolicy)); err != nil {
+			return fmt.Errorf("setting `tiering_policy`: %+v", err)
+		}
+
 		if retention, ok := properties.RetentionPolicy.AsLongTermRetentionPolicy(); ok && retention != nil {
 			if s := retention.DailySchedule; s != nil {
 				if err := d.Set("retention_daily", flattenBackupProtectionPolicyVMRetentionDaily(s)); err != nil {
@@ -517,6 +540,66 @@ func expandBackupProtectionPolicyVMRetentionWeeklyFormat(block map[string]interf
 	return &weekly
 }
 
+func expandBackupProtectionPolicyVMTieringPolicy(input []interface{}) map[string]*backup.TieringPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	result := make(map[string]*backup.TieringPolicy)
+	block := input[0].(map[string]interface{})
+
+	if v, ok := block["archived_restore_point"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		archived := v[0].(map[string]interface{})
+
+		policy := backup.TieringPolicy{
+			TieringMode: backup.TieringMode(archived["mode"].(string)),
+		}
+
+		if duration := archived["duration"].(int); duration != 0 {
+			policy.Duration = utils.Int32(int32(duration))
+		}
+
+		if durationType := archived["duration_type"].(string); durationType != "" {
+			policy.DurationType = backup.RetentionDurationType(durationType)
+		}
+
+		result[string(backup.RecoveryPointTierTypeArchivedRP)] = &policy
+	}
+
+	return result
+}
+
+func flattenBackupProtectionPolicyVMTieringPolicy(input map[string]*backup.TieringPolicy) []interface{} {
+	// the service can return a default entry for the archive tier when no tiering has been configured,
+	// since omitting the block is how tiering is disabled these are treated as if no policy was set
+	policy, ok := input[string(backup.RecoveryPointTierTypeArchivedRP)]
+	if !ok || policy == nil || policy.TieringMode == "" || policy.TieringMode == backup.TieringModeDoNotTier || policy.TieringMode == backup.TieringModeInvalid {
+		return []interface{}{}
+	}
+
+	duration := 0
+	if policy.Duration != nil {
+		duration = int(*policy.Duration)
+	}
+
+	durationType := ""
+	if policy.DurationType != backup.RetentionDurationTypeInvalid {
+		durationType = string(policy.DurationType)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"archived_restore_point": []interface{}{
+				map[string]interface{}{
+					"mode":          string(policy.TieringMode),
+					"duration":      duration,
+					"duration_type": durationType,
+				},
+			},
+		},
+	}
+}
+
 func flattenBackupProtectionPolicyVMSchedule(schedule *backup.SimpleSchedulePolicy) []interface{} {
 	block := map[string]interface{}{}
 
@@ -830,6 +913,50 @@ func resourceBackupProtectionPolicyVMSchema() map[string]*pluginsdk.Schema {
 			}, false),
 		},
 
+		"tiering_policy": {
+			Type:     pluginsdk.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"archived_restore_point": {
+						Type:     pluginsdk.TypeList,
+						MaxItems: 1,
+						Required: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"mode": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+									ValidateFunc: validation.StringInSlice([]string{
+										string(backup.TieringModeTierAfter),
+										string(backup.TieringModeTierRecommended),
+									}, false),
+								},
+
+								"duration": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IntBetween(1, 9999),
+								},
+
+								"duration_type": {
+									Type:     pluginsdk.TypeString,
+									Optional: true,
+									ValidateFunc: validation.StringInSlice([]string{
+										string(backup.RetentionDurationTypeDays),
+										string(backup.RetentionDurationTypeWeeks),
+										string(backup.RetentionDurationTypeMonths),
+										string(backup.RetentionDurationTypeYears),
+									}, false),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
 		"retention_daily": {
 			Type:     pluginsdk.TypeList,
 			MaxItems: 1,
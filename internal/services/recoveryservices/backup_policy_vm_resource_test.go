@@ -530,6 +530,45 @@ func TestAccBackupProtectionPolicyVM_updateWeeklyToPartialV2(t *testing.T) {
 	})
 }
 
+func TestAccBackupProtectionPolicyVM_tieringPolicyV2(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_backup_policy_vm", "test")
+	r := BackupProtectionPolicyVMResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.completeDaily(data, "V2"),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.tieringPolicy(data, "TierAfter"),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tiering_policy.0.archived_restore_point.0.mode").HasValue("TierAfter"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.tieringPolicy(data, "TierRecommended"),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tiering_policy.0.archived_restore_point.0.mode").HasValue("TierRecommended"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.completeDaily(data, "V2"),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tiering_policy.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t BackupProtectionPolicyVMResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.BackupPolicyID(state.ID)
 	if err != nil {
@@ -766,6 +805,60 @@ resource "azurerm_backup_policy_vm" "test" {
 `, r.template(data), data.RandomInteger, policyType)
 }
 
+func (r BackupProtectionPolicyVMResource) tieringPolicy(data acceptance.TestData, mode string) string {
+	duration := ""
+	if mode == "TierAfter" {
+		duration = `
+      duration      = 5
+      duration_type = "Months"`
+	}
+
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_policy_vm" "test" {
+  name                = "acctest-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  recovery_vault_name = azurerm_recovery_services_vault.test.name
+  timezone            = "UTC"
+  policy_type         = "V2"
+
+  backup {
+    frequency = "Daily"
+    time      = "23:00"
+  }
+
+  retention_daily {
+    count = 10
+  }
+
+  retention_weekly {
+    count    = 42
+    weekdays = ["Sunday", "Wednesday"]
+  }
+
+  retention_monthly {
+    count    = 7
+    weekdays = ["Sunday", "Wednesday"]
+    weeks    = ["First", "Last"]
+  }
+
+  retention_yearly {
+    count    = 77
+    weekdays = ["Sunday", "Wednesday"]
+    weeks    = ["First", "Last"]
+    months   = ["January", "July"]
+  }
+
+  tiering_policy {
+    archived_restore_point {
+      mode = "%s"%s
+    }
+  }
+}
+`, r.template(data), data.RandomInteger, mode, duration)
+}
+
 func (r BackupProtectionPolicyVMResource) completeWeekly(data acceptance.TestData, policyType string) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ResourceGuardProxyId struct {
+	SubscriptionId               string
+	ResourceGroup                string
+	VaultName                    string
+	BackupResourceGuardProxyName string
+}
+
+func NewResourceGuardProxyID(subscriptionId, resourceGroup, vaultName, backupResourceGuardProxyName string) ResourceGuardProxyId {
+	return ResourceGuardProxyId{
+		SubscriptionId:               subscriptionId,
+		ResourceGroup:                resourceGroup,
+		VaultName:                    vaultName,
+		BackupResourceGuardProxyName: backupResourceGuardProxyName,
+	}
+}
+
+func (id ResourceGuardProxyId) String() string {
+	segments := []string{
+		fmt.Sprintf("Backup Resource Guard Proxy Name %q", id.BackupResourceGuardProxyName),
+		fmt.Sprintf("Vault Name %q", id.VaultName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Resource Guard Proxy", segmentsStr)
+}
+
+func (id ResourceGuardProxyId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.RecoveryServices/vaults/%s/backupResourceGuardProxies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VaultName, id.BackupResourceGuardProxyName)
+}
+
+// ResourceGuardProxyID parses a ResourceGuardProxy ID into an ResourceGuardProxyId struct
+func ResourceGuardProxyID(input string) (*ResourceGuardProxyId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ResourceGuardProxyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VaultName, err = id.PopSegment("vaults"); err != nil {
+		return nil, err
+	}
+	if resourceId.BackupResourceGuardProxyName, err = id.PopSegment("backupResourceGuardProxies"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
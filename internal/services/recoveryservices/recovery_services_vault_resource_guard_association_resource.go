@@ -0,0 +1,171 @@
+package recoveryservices
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2021-12-01/backup"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2022-04-01/resourceguards"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VaultResourceGuardAssociationModel struct {
+	Name            string `tfschema:"name"`
+	VaultId         string `tfschema:"vault_id"`
+	ResourceGuardId string `tfschema:"resource_guard_id"`
+}
+
+type VaultResourceGuardAssociationResource struct{}
+
+var _ sdk.Resource = VaultResourceGuardAssociationResource{}
+
+func (r VaultResourceGuardAssociationResource) ResourceType() string {
+	return "azurerm_recovery_services_vault_resource_guard_association"
+}
+
+func (r VaultResourceGuardAssociationResource) ModelObject() interface{} {
+	return &VaultResourceGuardAssociationModel{}
+}
+
+func (r VaultResourceGuardAssociationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ResourceGuardProxyID
+}
+
+func (r VaultResourceGuardAssociationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice([]string{"VaultProxy"}, false),
+		},
+
+		"vault_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.VaultID,
+		},
+
+		"resource_guard_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: resourceguards.ValidateResourceGuardID,
+		},
+	}
+}
+
+func (r VaultResourceGuardAssociationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r VaultResourceGuardAssociationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model VaultResourceGuardAssociationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.RecoveryServices.ResourceGuardProxyClient
+
+			vaultId, err := parse.VaultID(model.VaultId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewResourceGuardProxyID(vaultId.SubscriptionId, vaultId.ResourceGroup, vaultId.Name, model.Name)
+
+			existing, err := client.Get(ctx, id.VaultName, id.ResourceGroup, id.BackupResourceGuardProxyName)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := backup.ResourceGuardProxyBaseResource{
+				Properties: &backup.ResourceGuardProxyBase{
+					ResourceGuardResourceID: utils.String(model.ResourceGuardId),
+				},
+			}
+
+			workaroundClient := azuresdkhacks.NewResourceGuardProxyWorkaroundClient(client)
+			if _, err := workaroundClient.Put(ctx, id.VaultName, id.ResourceGroup, id.BackupResourceGuardProxyName, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r VaultResourceGuardAssociationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.RecoveryServices.ResourceGuardProxyClient
+
+			id, err := parse.ResourceGuardProxyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.VaultName, id.ResourceGroup, id.BackupResourceGuardProxyName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := VaultResourceGuardAssociationModel{
+				Name:    id.BackupResourceGuardProxyName,
+				VaultId: parse.NewVaultID(id.SubscriptionId, id.ResourceGroup, id.VaultName).ID(),
+			}
+
+			if props := resp.Properties; props != nil && props.ResourceGuardResourceID != nil {
+				resourceGuardId, err := resourceguards.ParseResourceGuardIDInsensitively(*props.ResourceGuardResourceID)
+				if err != nil {
+					return err
+				}
+				state.ResourceGuardId = resourceGuardId.ID()
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VaultResourceGuardAssociationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.RecoveryServices.ResourceGuardProxyClient
+
+			id, err := parse.ResourceGuardProxyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, id.VaultName, id.ResourceGroup, id.BackupResourceGuardProxyName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -23,6 +23,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		BackupProtectionPolicyVMWorkloadResource{},
+		VaultResourceGuardAssociationResource{},
 	}
 }
 
@@ -119,6 +119,7 @@ type ClusterResourceModel struct {
 	Sku                  managedcluster.SkuName               `tfschema:"sku"`
 	Tags                 map[string]interface{}               `tfschema:"tags"`
 	UpgradeWave          managedcluster.ClusterUpgradeCadence `tfschema:"upgrade_wave"`
+	ZonalResiliency      bool                                 `tfschema:"zonal_resiliency_enabled"`
 }
 
 func (k ClusterResource) Arguments() map[string]*pluginsdk.Schema {
@@ -223,6 +224,12 @@ func (k ClusterResource) Arguments() map[string]*pluginsdk.Schema {
 				string(managedcluster.ClusterUpgradeCadenceWaveTwo),
 			}, false),
 		},
+		"zonal_resiliency_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
 	}
 }
 
@@ -740,6 +747,10 @@ func flattenClusterProperties(cluster *managedcluster.ManagedCluster) *ClusterRe
 		model.UpgradeWave = *upgradeWave
 	}
 
+	if zonalResiliency := properties.ZonalResiliency; zonalResiliency != nil {
+		model.ZonalResiliency = *zonalResiliency
+	}
+
 	if t := cluster.Tags; t != nil {
 		modelTags := make(map[string]interface{})
 		for tag, value := range *t {
@@ -863,6 +874,7 @@ func expandClusterProperties(model *ClusterResourceModel) *managedcluster.Manage
 
 	out.ClientConnectionPort = &model.ClientConnectionPort
 	out.ClusterUpgradeCadence = &model.UpgradeWave
+	out.ZonalResiliency = utils.Bool(model.ZonalResiliency)
 
 	if customSettings := model.CustomFabricSettings; len(customSettings) > 0 {
 		fs := make([]managedcluster.SettingsSectionDescription, len(customSettings))
@@ -94,6 +94,21 @@ func TestAccServiceFabricManagedCluster_full(t *testing.T) {
 	})
 }
 
+func TestAccServiceFabricManagedCluster_zonalResiliency(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_cluster", "test")
+	r := ClusterResource{}
+	nodeTypeData1 := r.nodeType("test1", true, 130)
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.zonalResiliency(data, nodeTypeData1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("zonal_resiliency_enabled").HasValue("true")),
+		},
+		data.ImportStep("password"),
+	})
+}
+
 func (r ClusterResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	resourceID, err := managedcluster.ParseManagedClusterID(state.ID)
 	if err != nil {
@@ -151,6 +166,43 @@ resource "azurerm_service_fabric_managed_cluster" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, nodeTypeData)
 }
 
+func (r ClusterResource) zonalResiliency(data acceptance.TestData, nodeTypeData string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-sfmc-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_service_fabric_managed_cluster" "test" {
+  name                     = "testacc-sfmc-%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  sku                      = "Standard"
+  username                 = "testUser"
+  password                 = "NotV3ryS3cur3P@$$w0rd"
+  dns_service_enabled      = true
+  zonal_resiliency_enabled = true
+
+  client_connection_port = 12345
+  http_gateway_port      = 23456
+
+  lb_rule {
+    backend_port       = 8000
+    frontend_port      = 443
+    probe_protocol     = "http"
+    protocol           = "tcp"
+    probe_request_path = "/"
+  }
+
+  %[4]s
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, nodeTypeData)
+}
+
 func (r ClusterResource) requiresImport(data acceptance.TestData, nt string) string {
 	return fmt.Sprintf(`
 %[1]s